@@ -0,0 +1,146 @@
+// Command aoc is the unified Advent of Code 2024 runner. It dispatches to
+// whichever day package has registered itself with the solver package,
+// handling input fetching/caching and timing so individual days don't
+// have to.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/firstChairCoder/advent-of-code-2024/solver"
+
+	_ "github.com/firstChairCoder/advent-of-code-2024/days/day01"
+	_ "github.com/firstChairCoder/advent-of-code-2024/days/day02"
+)
+
+func main() {
+	day := flag.Int("day", 0, "day number to run (1-25)")
+	part := flag.Int("part", 0, "part to run (1 or 2, 0 for both)")
+	inputPath := flag.String("input", "", "path to the puzzle input (default: inputs/day-NN.txt, auto-downloaded if missing)")
+	parallel := flag.Bool("parallel", false, "use the day's worker-pool fast path, if it has one")
+	workers := flag.Int("workers", 0, "worker count for -parallel (0 = runtime.NumCPU())")
+	flag.Parse()
+
+	if err := run(*day, *part, *inputPath, *parallel, *workers); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(day, part int, inputPath string, parallel bool, workers int) error {
+	if day <= 0 {
+		return fmt.Errorf("aoc: -day is required")
+	}
+	s, ok := solver.Get(day)
+	if !ok {
+		return fmt.Errorf("aoc: no solver registered for day %d", day)
+	}
+
+	if inputPath == "" {
+		inputPath = filepath.Join("inputs", fmt.Sprintf("day-%02d.txt", day))
+	}
+	data, err := loadInput(day, inputPath)
+	if err != nil {
+		return err
+	}
+
+	if parallel {
+		if ps, ok := s.(solver.ParallelSolver); ok {
+			return runParallel(ps, data, day, workers)
+		}
+	}
+
+	if part == 0 || part == 1 {
+		if err := runPart(s.Part1, data, day, 1); err != nil {
+			return err
+		}
+	}
+	if part == 0 || part == 2 {
+		if err := runPart(s.Part2, data, day, 2); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func runParallel(ps solver.ParallelSolver, data []byte, day, workers int) error {
+	start := time.Now()
+	p1, p2, err := ps.Parallel(bytes.NewReader(data), workers)
+	elapsed := time.Since(start)
+	if err != nil {
+		return fmt.Errorf("day %d parallel: %w", day, err)
+	}
+	fmt.Printf("day %02d part 1: %s\n", day, p1)
+	fmt.Printf("day %02d part 2: %s\n", day, p2)
+	fmt.Printf("day %02d parallel: %s\n", day, elapsed)
+	return nil
+}
+
+func runPart(fn func(io.Reader) (string, error), data []byte, day, part int) error {
+	start := time.Now()
+	answer, err := fn(bytes.NewReader(data))
+	elapsed := time.Since(start)
+	if err != nil {
+		return fmt.Errorf("day %d part %d: %w", day, part, err)
+	}
+	fmt.Printf("day %02d part %d: %s (%s)\n", day, part, answer, elapsed)
+	return nil
+}
+
+// loadInput returns the puzzle input for day, reading it from path if it
+// already exists on disk, or downloading and caching it there otherwise.
+func loadInput(day int, path string) ([]byte, error) {
+	if data, err := os.ReadFile(path); err == nil {
+		return data, nil
+	} else if !os.IsNotExist(err) {
+		return nil, fmt.Errorf("aoc: reading %s: %w", path, err)
+	}
+
+	data, err := downloadInput(day)
+	if err != nil {
+		return nil, fmt.Errorf("aoc: fetching input for day %d: %w", day, err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("aoc: creating %s: %w", filepath.Dir(path), err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return nil, fmt.Errorf("aoc: caching input to %s: %w", path, err)
+	}
+	return data, nil
+}
+
+// downloadInput fetches a day's puzzle input from adventofcode.com using
+// the session cookie in $AOC_SESSION.
+func downloadInput(day int) ([]byte, error) {
+	session := os.Getenv("AOC_SESSION")
+	if session == "" {
+		return nil, fmt.Errorf("input not cached locally and $AOC_SESSION is not set")
+	}
+
+	url := fmt.Sprintf("https://adventofcode.com/2024/day/%d/input", day)
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.AddCookie(&http.Cookie{Name: "session", Value: session})
+	req.Header.Set("User-Agent", "advent-of-code-2024 runner (github.com/firstChairCoder/advent-of-code-2024)")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
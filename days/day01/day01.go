@@ -0,0 +1,72 @@
+// Package day01 solves Advent of Code 2024 day 1: Historian Hysteria.
+package day01
+
+import (
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/firstChairCoder/advent-of-code-2024/internal/nums"
+	"github.com/firstChairCoder/advent-of-code-2024/internal/parse"
+	"github.com/firstChairCoder/advent-of-code-2024/solver"
+)
+
+func init() {
+	solver.Register(1, impl{})
+}
+
+// impl implements solver.Solver for day 1. It carries no state; each
+// method re-parses the reader it's given.
+type impl struct{}
+
+// readLists reads the two space-separated number columns into list1
+// and list2, in file order.
+func readLists(r io.Reader) (list1, list2 []int, err error) {
+	err = parse.ScanLines(r, func(lineNo int, fields []int) error {
+		if len(fields) != 2 {
+			return fmt.Errorf("day01: line %d: want 2 fields, got %d", lineNo, len(fields))
+		}
+		list1 = append(list1, fields[0])
+		list2 = append(list2, fields[1])
+		return nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("day01: %w", err)
+	}
+	return list1, list2, nil
+}
+
+// Part1 returns the total distance between the two sorted lists.
+func (impl) Part1(r io.Reader) (string, error) {
+	list1, list2, err := readLists(r)
+	if err != nil {
+		return "", err
+	}
+	slices.Sort(list1)
+	slices.Sort(list2)
+
+	total := 0
+	for i := range list1 {
+		total += nums.Abs(list2[i] - list1[i])
+	}
+	return fmt.Sprintf("%d", total), nil
+}
+
+// Part2 returns the similarity score: each number in list1 multiplied by
+// the number of times it appears in list2, summed.
+func (impl) Part2(r io.Reader) (string, error) {
+	list1, list2, err := readLists(r)
+	if err != nil {
+		return "", err
+	}
+	counts2 := map[int]int{}
+	for _, n := range list2 {
+		counts2[n]++
+	}
+
+	total := 0
+	for _, n := range list1 {
+		total += n * counts2[n]
+	}
+	return fmt.Sprintf("%d", total), nil
+}
@@ -0,0 +1,201 @@
+// Package day02 solves Advent of Code 2024 day 2: Red-Nosed Reports.
+package day02
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/firstChairCoder/advent-of-code-2024/internal/nums"
+	"github.com/firstChairCoder/advent-of-code-2024/internal/parse"
+	"github.com/firstChairCoder/advent-of-code-2024/solver"
+)
+
+func init() {
+	solver.Register(2, impl{})
+}
+
+// impl implements solver.Solver for day 2. It carries no state; each
+// method re-parses the reader it's given.
+type impl struct{}
+
+// reports parses the input into one []int per line (report). Each report
+// is copied out of parse.ScanLines' reused buffer before being stored.
+func reports(r io.Reader) ([][]int, error) {
+	var out [][]int
+	err := parse.ScanLines(r, func(lineNo int, fields []int) error {
+		out = append(out, append([]int(nil), fields...))
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("day02: %w", err)
+	}
+	return out, nil
+}
+
+// Part1 returns the count of reports that are safe on their own.
+func (impl) Part1(r io.Reader) (string, error) {
+	rs, err := reports(r)
+	if err != nil {
+		return "", err
+	}
+	count := 0
+	for _, report := range rs {
+		if check(report) {
+			count++
+		}
+	}
+	return fmt.Sprintf("%d", count), nil
+}
+
+// Part2 returns the count of reports that are safe, or can be made safe
+// by removing exactly one level (the "Problem Dampener").
+func (impl) Part2(r io.Reader) (string, error) {
+	rs, err := reports(r)
+	if err != nil {
+		return "", err
+	}
+	count := 0
+	for _, report := range rs {
+		if check2(report) {
+			count++
+		}
+	}
+	return fmt.Sprintf("%d", count), nil
+}
+
+// Parallel implements solver.ParallelSolver: it computes both parts in a
+// single pass, fanning the input's lines across a worker pool via
+// solver.RunParallel instead of solving them one at a time.
+func (impl) Parallel(r io.Reader, workers int) (part1, part2 string, err error) {
+	lines, err := readLines(r)
+	if err != nil {
+		return "", "", fmt.Errorf("day02: %w", err)
+	}
+	totalP1, totalP2, err := solver.RunParallel(lines, workers, reportCounts)
+	if err != nil {
+		return "", "", fmt.Errorf("day02: %w", err)
+	}
+	return fmt.Sprintf("%d", totalP1), fmt.Sprintf("%d", totalP2), nil
+}
+
+// readLines returns the input's non-blank lines, unparsed, for handing
+// off to solver.RunParallel.
+func readLines(r io.Reader) ([]string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines, scanner.Err()
+}
+
+// reportCounts parses a single report line and reports whether it counts
+// toward part 1 (safe outright) and part 2 (safe, possibly dampened). A
+// malformed line is returned as an error rather than silently counting
+// as unsafe, matching the non-parallel path's behavior of aborting on a
+// *parse.ParseError.
+func reportCounts(line string) (p1, p2 int, err error) {
+	report, err := parse.ParseInts(line, ' ')
+	if err != nil {
+		return 0, 0, err
+	}
+	if check(report) {
+		p1 = 1
+	}
+	if check2(report) {
+		p2 = 1
+	}
+	return p1, p2, nil
+}
+
+// check verifies if the report sequence 'r' is safe according to the
+// problem rules. A sequence is safe if the differences between
+// consecutive numbers are:
+//  1. Consistently positive OR consistently negative (no sign flips).
+//  2. Have an absolute value between 1 and 3 (inclusive).
+func check(r []int) bool {
+	if len(r) < 2 {
+		return true
+	}
+
+	firstDiffSign := nums.Sign(r[1] - r[0])
+	if firstDiffSign == 0 {
+		return false // flat sequences are never safe
+	}
+	for i := 1; i < len(r); i++ {
+		d := r[i] - r[i-1]
+		if nums.Sign(d) != firstDiffSign || nums.Abs(d) > 3 {
+			return false
+		}
+	}
+	return true
+}
+
+// check2 is the "Problem Dampener" version of check: it reports the
+// report safe if it's safe outright, or can be made safe by removing a
+// single level. Unlike the naive approach of cloning the report and
+// re-running check for every candidate removal (O(n) per candidate,
+// O(n^2) overall), it precomputes, for each direction, how far a
+// monotonic run extends from the front and from the back, then checks
+// every candidate removal in O(1) against those spans. That makes the
+// whole function O(n).
+func check2(r []int) bool {
+	if len(r) < 2 {
+		return true
+	}
+	return dampenable(r, 1) || dampenable(r, -1)
+}
+
+// validStep reports whether d is a legal step in direction dir: same
+// sign as dir and within [1, 3] in magnitude.
+func validStep(dir, d int) bool {
+	return nums.Sign(d) == dir && nums.Abs(d) <= 3
+}
+
+// dampenable reports whether r is safe, or can be made safe by removing
+// one element, when steps are required to move in direction dir (+1 for
+// increasing, -1 for decreasing).
+//
+// prefixOK[i] is true iff r[0..i] has no illegal step, and suffixOK[i] is
+// true iff r[i..len(r)-1] has no illegal step. Removing index i yields a
+// safe report iff the prefix before i and the suffix after i are each
+// internally valid, and the "bridge" step that removal creates (from
+// r[i-1] directly to r[i+1]) is itself legal.
+func dampenable(r []int, dir int) bool {
+	n := len(r)
+
+	prefixOK := make([]bool, n)
+	ok := true
+	for i := 0; i < n; i++ {
+		if i > 0 && !validStep(dir, r[i]-r[i-1]) {
+			ok = false
+		}
+		prefixOK[i] = ok
+	}
+	if prefixOK[n-1] {
+		return true // already safe without removing anything
+	}
+
+	suffixOK := make([]bool, n)
+	ok = true
+	for i := n - 1; i >= 0; i-- {
+		if i < n-1 && !validStep(dir, r[i+1]-r[i]) {
+			ok = false
+		}
+		suffixOK[i] = ok
+	}
+
+	for i := 0; i < n; i++ {
+		prefixSafe := i == 0 || prefixOK[i-1]
+		suffixSafe := i == n-1 || suffixOK[i+1]
+		bridgeSafe := i == 0 || i == n-1 || validStep(dir, r[i+1]-r[i-1])
+		if prefixSafe && suffixSafe && bridgeSafe {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,108 @@
+package day02
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/firstChairCoder/advent-of-code-2024/internal/parse"
+)
+
+// syntheticLines renders n synthetic reports of the given length as raw
+// input lines, for feeding to the serial and parallel solve paths alike.
+func syntheticLines(n, length int) []string {
+	reports := syntheticReports(n, length)
+	lines := make([]string, len(reports))
+	for i, report := range reports {
+		fields := make([]string, len(report))
+		for j, v := range report {
+			fields[j] = strconv.Itoa(v)
+		}
+		lines[i] = strings.Join(fields, " ")
+	}
+	return lines
+}
+
+// TestParallelMatchesSerial checks that Parallel's worker-pool fast path
+// produces the same totals as summing reportCounts serially, across
+// several worker counts, since RunParallel only gets to reorder the
+// sum-contributing calls, never to change what they return.
+func TestParallelMatchesSerial(t *testing.T) {
+	lines := syntheticLines(500, 30)
+
+	var wantP1, wantP2 int
+	for _, line := range lines {
+		p1, p2, err := reportCounts(line)
+		if err != nil {
+			t.Fatalf("reportCounts(%q): %v", line, err)
+		}
+		wantP1 += p1
+		wantP2 += p2
+	}
+
+	for _, workers := range []int{0, 1, 2, 8} {
+		p1Str, p2Str, err := impl{}.Parallel(strings.NewReader(strings.Join(lines, "\n")), workers)
+		if err != nil {
+			t.Fatalf("workers=%d: Parallel: %v", workers, err)
+		}
+		if p1Str != fmt.Sprintf("%d", wantP1) || p2Str != fmt.Sprintf("%d", wantP2) {
+			t.Errorf("workers=%d: Parallel = (%s, %s), want (%d, %d)", workers, p1Str, p2Str, wantP1, wantP2)
+		}
+	}
+}
+
+// TestParallelMalformedLineReturnsError checks that Parallel surfaces a
+// *parse.ParseError on a bad line instead of silently treating it as
+// unsafe for both parts, matching Part1/Part2's behavior on the same
+// input via reports()/parse.ScanLines.
+func TestParallelMalformedLineReturnsError(t *testing.T) {
+	input := "1 2 3\n4 5 6\n7 8x 9\n10 11 12\n"
+
+	_, _, err := impl{}.Parallel(strings.NewReader(input), 0)
+	if err == nil {
+		t.Fatal("Parallel: expected an error, got nil")
+	}
+	var pe *parse.ParseError
+	if !errors.As(err, &pe) {
+		t.Fatalf("Parallel: error is %T, want one wrapping *parse.ParseError", err)
+	}
+	if pe.Text != "8x" {
+		t.Errorf("ParseError.Text = %q, want %q", pe.Text, "8x")
+	}
+}
+
+// BenchmarkSolveSerial and BenchmarkSolveParallel compare the line-at-a-
+// time loop against solver.RunParallel on a 1M-line synthetic input, to
+// demonstrate the speedup -parallel is for.
+func benchmarkInput() string {
+	return strings.Join(syntheticLines(1_000_000, 10), "\n")
+}
+
+func BenchmarkSolveSerial(b *testing.B) {
+	input := benchmarkInput()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p1, p2 := 0, 0
+		for _, line := range strings.Split(input, "\n") {
+			a, c, err := reportCounts(line)
+			if err != nil {
+				b.Fatal(err)
+			}
+			p1 += a
+			p2 += c
+		}
+		_, _ = p1, p2
+	}
+}
+
+func BenchmarkSolveParallel(b *testing.B) {
+	input := benchmarkInput()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, _, err := (impl{}).Parallel(strings.NewReader(input), 0); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
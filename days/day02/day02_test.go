@@ -0,0 +1,89 @@
+package day02
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// checkQuadratic is the original Problem Dampener approach: for every
+// candidate index, clone the report, delete that index, and re-run
+// check. Kept here only so BenchmarkCheck2Quadratic can show the speedup
+// check2's O(n) approach gets over it.
+func checkQuadratic(r []int) bool {
+	if check(r) {
+		return true
+	}
+	for i := range r {
+		if check(slices.Delete(slices.Clone(r), i, i+1)) {
+			return true
+		}
+	}
+	return false
+}
+
+// syntheticReports builds n mostly-monotonic reports of the given length,
+// each with its middle level shifted by a random amount that may or may
+// not violate the safety rules, so both the "already safe" and "needs
+// dampening" paths get exercised.
+func syntheticReports(n, length int) [][]int {
+	rng := rand.New(rand.NewSource(1))
+	reports := make([][]int, n)
+	for i := range reports {
+		report := make([]int, length)
+		report[0] = rng.Intn(10)
+		for j := 1; j < length; j++ {
+			report[j] = report[j-1] + 1 + rng.Intn(3)
+		}
+		// Perturb one level so a meaningful fraction of reports need
+		// the dampener rather than passing check outright.
+		if length > 2 {
+			report[length/2] += rng.Intn(7) - 3
+		}
+		reports[i] = report
+	}
+	return reports
+}
+
+// TestCheck2MatchesQuadratic guards the O(n) rewrite against the
+// reference O(n^2) implementation across a mix of safe, unsafe, and
+// borderline reports.
+func TestCheck2MatchesQuadratic(t *testing.T) {
+	reports := syntheticReports(200, 20)
+	reports = append(reports,
+		[]int{1, 2, 3, 4, 5},
+		[]int{5, 4, 3, 2, 1},
+		[]int{1, 1, 1},
+		[]int{1, 5, 1},
+		[]int{1},
+		[]int{},
+		[]int{9, 7, 6, 2, 1},
+		[]int{1, 3, 2, 4, 5},
+	)
+	for _, report := range reports {
+		got, want := check2(report), checkQuadratic(report)
+		if got != want {
+			t.Errorf("check2(%v) = %v, want %v", report, got, want)
+		}
+	}
+}
+
+func BenchmarkCheck2Quadratic(b *testing.B) {
+	reports := syntheticReports(1000, 500)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, report := range reports {
+			checkQuadratic(report)
+		}
+	}
+}
+
+func BenchmarkCheck2Linear(b *testing.B) {
+	reports := syntheticReports(1000, 500)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, report := range reports {
+			check2(report)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+// Package nums collects the small generic numeric helpers that every day
+// ends up wanting (absolute value, sign, clamping, sums, min/max) so they
+// don't get reimplemented inline in each day's package.
+package nums
+
+import "golang.org/x/exp/constraints"
+
+// Number is any type Sum can add together: the integer and
+// floating-point types constraints.Ordered also covers, minus strings.
+type Number interface {
+	constraints.Integer | constraints.Float
+}
+
+// Abs returns the absolute value of x.
+func Abs[T constraints.Signed](x T) T {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// Sign returns -1, 0, or 1 according to the sign of x.
+func Sign[T constraints.Signed](x T) int {
+	switch {
+	case x < 0:
+		return -1
+	case x > 0:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Clamp restricts v to the closed range [lo, hi].
+func Clamp[T constraints.Ordered](v, lo, hi T) T {
+	if v < lo {
+		return lo
+	}
+	if v > hi {
+		return hi
+	}
+	return v
+}
+
+// Sum returns the sum of vs, or the zero value for an empty slice.
+func Sum[T Number](vs []T) T {
+	var total T
+	for _, v := range vs {
+		total += v
+	}
+	return total
+}
+
+// Min returns the smallest of vs. Min panics if vs is empty.
+func Min[T constraints.Ordered](vs ...T) T {
+	m := vs[0]
+	for _, v := range vs[1:] {
+		if v < m {
+			m = v
+		}
+	}
+	return m
+}
+
+// Max returns the largest of vs. Max panics if vs is empty.
+func Max[T constraints.Ordered](vs ...T) T {
+	m := vs[0]
+	for _, v := range vs[1:] {
+		if v > m {
+			m = v
+		}
+	}
+	return m
+}
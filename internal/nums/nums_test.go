@@ -0,0 +1,51 @@
+package nums
+
+import "testing"
+
+func TestAbs(t *testing.T) {
+	cases := map[int]int{-3: 3, 0: 0, 5: 5}
+	for in, want := range cases {
+		if got := Abs(in); got != want {
+			t.Errorf("Abs(%d) = %d, want %d", in, got, want)
+		}
+	}
+}
+
+func TestSign(t *testing.T) {
+	cases := map[int]int{-3: -1, 0: 0, 5: 1}
+	for in, want := range cases {
+		if got := Sign(in); got != want {
+			t.Errorf("Sign(%d) = %d, want %d", in, got, want)
+		}
+	}
+}
+
+func TestClamp(t *testing.T) {
+	if got := Clamp(5, 0, 3); got != 3 {
+		t.Errorf("Clamp(5, 0, 3) = %d, want 3", got)
+	}
+	if got := Clamp(-1, 0, 3); got != 0 {
+		t.Errorf("Clamp(-1, 0, 3) = %d, want 0", got)
+	}
+	if got := Clamp(2, 0, 3); got != 2 {
+		t.Errorf("Clamp(2, 0, 3) = %d, want 2", got)
+	}
+}
+
+func TestSum(t *testing.T) {
+	if got := Sum([]int{1, 2, 3}); got != 6 {
+		t.Errorf("Sum([1,2,3]) = %d, want 6", got)
+	}
+	if got := Sum([]int{}); got != 0 {
+		t.Errorf("Sum([]) = %d, want 0", got)
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	if got := Min(3, 1, 2); got != 1 {
+		t.Errorf("Min(3, 1, 2) = %d, want 1", got)
+	}
+	if got := Max(3, 1, 2); got != 3 {
+		t.Errorf("Max(3, 1, 2) = %d, want 3", got)
+	}
+}
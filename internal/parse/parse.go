@@ -0,0 +1,124 @@
+// Package parse provides small, allocation-conscious helpers for reading
+// the whitespace/separator-delimited integer lines that show up
+// throughout Advent of Code inputs.
+package parse
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ParseError reports where, in terms of line and column, a line of input
+// failed to parse as a run of integers.
+type ParseError struct {
+	Line int    // 1-based line number; 0 if unknown to the caller
+	Col  int    // 0-based byte offset within the line
+	Text string // the offending field
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("parse: line %d, col %d: invalid integer %q", e.Line, e.Col, e.Text)
+}
+
+// ParseInts splits line on runs of sep and parses each field as a signed
+// integer. Consecutive separators (and leading/trailing ones) are
+// skipped rather than producing empty fields, so "1   2" and "1 2" parse
+// the same way under sep=' '.
+func ParseInts(line string, sep byte) ([]int, error) {
+	return appendInts(nil, line, sep)
+}
+
+// appendInts parses line into buf, reusing buf's backing array when it
+// has enough capacity, and returns the resulting slice.
+func appendInts(buf []int, line string, sep byte) ([]int, error) {
+	buf = buf[:0]
+	start := -1
+	for i := 0; i <= len(line); i++ {
+		if i < len(line) && line[i] != sep {
+			if start == -1 {
+				start = i
+			}
+			continue
+		}
+		if start == -1 {
+			continue // run of separators; nothing to emit
+		}
+		n, err := parseInt(line[start:i])
+		if err != nil {
+			return nil, &ParseError{Col: start, Text: line[start:i]}
+		}
+		buf = append(buf, n)
+		start = -1
+	}
+	return buf, nil
+}
+
+// parseInt is a hand-rolled strconv.Atoi-style scanner for a single field.
+// It avoids strconv's allocation-prone error wrapping since ScanLines
+// calls it once per field on every line of the input.
+func parseInt(s string) (int, error) {
+	if s == "" {
+		return 0, fmt.Errorf("empty field")
+	}
+	i, neg := 0, false
+	switch s[0] {
+	case '-':
+		neg = true
+		i = 1
+	case '+':
+		i = 1
+	}
+	if i == len(s) {
+		return 0, fmt.Errorf("no digits")
+	}
+	n := 0
+	for ; i < len(s); i++ {
+		c := s[i]
+		if c < '0' || c > '9' {
+			return 0, fmt.Errorf("non-digit byte %q", c)
+		}
+		n = n*10 + int(c-'0')
+	}
+	if neg {
+		n = -n
+	}
+	return n, nil
+}
+
+// ScanLines reads r line by line, parsing each non-blank line as
+// sep-delimited integers and calling fn with the 1-based line number and
+// the parsed fields. The fields slice is reused across calls: if fn needs
+// to retain it past the call, it must copy.
+//
+// If a line fails to parse, ScanLines returns the *ParseError with its
+// Line field filled in and stops; fn is not called for that line.
+func ScanLines(r io.Reader, fn func(lineNo int, fields []int) error) error {
+	scanner := bufio.NewScanner(r)
+	var buf []int
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		raw := scanner.Text()
+		line := strings.TrimSpace(raw)
+		if line == "" {
+			continue
+		}
+
+		var err error
+		buf, err = appendInts(buf, line, ' ')
+		if err != nil {
+			if pe, ok := err.(*ParseError); ok {
+				pe.Line = lineNo
+				pe.Col += strings.Index(raw, line) // undo the leading trim
+			}
+			return err
+		}
+
+		if err := fn(lineNo, buf); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
@@ -0,0 +1,93 @@
+package parse
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseIntsNormal(t *testing.T) {
+	got, err := ParseInts("1   2   3", ' ')
+	if err != nil {
+		t.Fatalf("ParseInts: unexpected error: %v", err)
+	}
+	if want := []int{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseInts = %v, want %v", got, want)
+	}
+}
+
+func TestParseIntsLeadingTrailingSeparators(t *testing.T) {
+	got, err := ParseInts("  1 2  ", ' ')
+	if err != nil {
+		t.Fatalf("ParseInts: unexpected error: %v", err)
+	}
+	if want := []int{1, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseInts = %v, want %v", got, want)
+	}
+}
+
+func TestParseIntsSignPrefixes(t *testing.T) {
+	got, err := ParseInts("-3 +5 2", ' ')
+	if err != nil {
+		t.Fatalf("ParseInts: unexpected error: %v", err)
+	}
+	if want := []int{-3, 5, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseInts = %v, want %v", got, want)
+	}
+}
+
+func TestParseIntsEmptyLine(t *testing.T) {
+	got, err := ParseInts("", ' ')
+	if err != nil {
+		t.Fatalf("ParseInts: unexpected error: %v", err)
+	}
+	if len(got) != 0 {
+		t.Errorf("ParseInts(\"\") = %v, want empty", got)
+	}
+}
+
+func TestParseIntsBadField(t *testing.T) {
+	_, err := ParseInts("1 2x 3", ' ')
+	if err == nil {
+		t.Fatal("ParseInts: expected an error, got nil")
+	}
+	var pe *ParseError
+	if !errors.As(err, &pe) {
+		t.Fatalf("ParseInts: error is %T, want *ParseError", err)
+	}
+	if pe.Col != 2 {
+		t.Errorf("ParseError.Col = %d, want 2", pe.Col)
+	}
+	if pe.Text != "2x" {
+		t.Errorf("ParseError.Text = %q, want %q", pe.Text, "2x")
+	}
+	// ParseInts has no line context to attach; that's ScanLines' job.
+	if pe.Line != 0 {
+		t.Errorf("ParseError.Line = %d, want 0", pe.Line)
+	}
+}
+
+func TestScanLinesBadFieldReportsLine(t *testing.T) {
+	input := "1 2\n3 4\n5 6x 7\n8 9\n"
+
+	err := ScanLines(strings.NewReader(input), func(lineNo int, fields []int) error {
+		return nil
+	})
+	if err == nil {
+		t.Fatal("ScanLines: expected an error, got nil")
+	}
+	var pe *ParseError
+	if !errors.As(err, &pe) {
+		t.Fatalf("ScanLines: error is %T, want *ParseError", err)
+	}
+	if pe.Line != 3 {
+		t.Errorf("ParseError.Line = %d, want 3", pe.Line)
+	}
+	if pe.Col != 2 {
+		t.Errorf("ParseError.Col = %d, want 2", pe.Col)
+	}
+	if pe.Text != "6x" {
+		t.Errorf("ParseError.Text = %q, want %q", pe.Text, "6x")
+	}
+}
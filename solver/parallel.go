@@ -0,0 +1,60 @@
+package solver
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// RunParallel fans lines out across workers goroutines (runtime.NumCPU()
+// if workers <= 0), calling fn once per line and summing the two return
+// values across all of them.
+//
+// This only works because the per-line contributions are commutative:
+// each fn call returns a count to add to the running totals, and
+// addition doesn't care what order the lines arrive in. A day whose
+// answer instead depends on line order (or on state shared between
+// lines) cannot use RunParallel as-is.
+//
+// If fn returns an error for any line, RunParallel returns zero totals
+// and the first error any worker reported. The remaining lines are still
+// processed; with more than one bad line, which line's error is returned
+// depends on scheduling rather than on line order.
+func RunParallel(lines []string, workers int, fn func(line string) (p1, p2 int, err error)) (totalP1, totalP2 int, err error) {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	jobs := make(chan string, workers)
+	var p1Sum, p2Sum int64
+	var firstErr error
+	var errOnce sync.Once
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			for line := range jobs {
+				p1, p2, lineErr := fn(line)
+				if lineErr != nil {
+					errOnce.Do(func() { firstErr = lineErr })
+					continue
+				}
+				atomic.AddInt64(&p1Sum, int64(p1))
+				atomic.AddInt64(&p2Sum, int64(p2))
+			}
+		}()
+	}
+
+	for _, line := range lines {
+		jobs <- line
+	}
+	close(jobs)
+	wg.Wait()
+
+	if firstErr != nil {
+		return 0, 0, firstErr
+	}
+	return int(p1Sum), int(p2Sum), nil
+}
@@ -0,0 +1,53 @@
+// Package solver defines the shared contract that every Advent of Code day
+// implements, plus the registry that lets the cmd/aoc runner find them.
+//
+// A day's package never calls the runner directly. Instead it imports
+// solver, implements Solver, and registers itself from an init() function.
+// cmd/aoc then blank-imports every days/dayNN package so registration runs
+// as a side effect of the import, and dispatches by day number at runtime.
+package solver
+
+import (
+	"fmt"
+	"io"
+)
+
+// Solver is implemented by each day's package. Part1 and Part2 each read
+// the puzzle input from scratch (the runner hands them a fresh reader per
+// call) and return the answer as a string, since some days' answers aren't
+// numeric.
+type Solver interface {
+	Part1(r io.Reader) (string, error)
+	Part2(r io.Reader) (string, error)
+}
+
+// registry maps a day number (1-25) to the Solver that handles it.
+var registry = map[int]Solver{}
+
+// Register associates a Solver with a day number. Days call this from
+// init() so that simply importing a day's package is enough to make it
+// available to the runner.
+//
+// Register panics if the same day is registered twice, since that always
+// indicates a copy-paste mistake rather than a condition to recover from.
+func Register(day int, s Solver) {
+	if _, exists := registry[day]; exists {
+		panic(fmt.Sprintf("solver: day %d already registered", day))
+	}
+	registry[day] = s
+}
+
+// Get looks up the Solver registered for day, if any.
+func Get(day int) (Solver, bool) {
+	s, ok := registry[day]
+	return s, ok
+}
+
+// ParallelSolver is an optional interface a day can implement to offer a
+// faster path for -parallel runs, fanning its input lines across a
+// worker pool (typically via RunParallel) instead of solving serially.
+// cmd/aoc falls back to the regular Solver methods when a day doesn't
+// implement it.
+type ParallelSolver interface {
+	Parallel(r io.Reader, workers int) (part1, part2 string, err error)
+}